@@ -0,0 +1,141 @@
+package auction_entity
+
+import (
+	"context"
+	"time"
+
+	"fullcycle-auction_go/internal/internal_error"
+
+	"github.com/google/uuid"
+)
+
+type AuctionStatus int
+type ProductCondition int
+type AuctionKind int
+
+// An auction starts Upcoming only when it is created with a future
+// BeginAt; otherwise it starts Active right away, as before.
+const (
+	Upcoming AuctionStatus = iota
+	Active
+	Completed
+)
+
+const (
+	New ProductCondition = iota
+	Used
+	Refurbished
+)
+
+// AuctionKind distinguishes a classic forward auction, where bidders
+// compete by raising their offer, from a reverse auction, where sellers
+// compete by lowering their asking price for a fixed lot.
+const (
+	Forward AuctionKind = iota
+	Reverse
+)
+
+type Auction struct {
+	Id          string
+	ProductName string
+	Category    string
+	Description string
+	Condition   ProductCondition
+	Kind        AuctionKind
+	Status      AuctionStatus
+	Timestamp   time.Time
+	BeginAt     time.Time
+}
+
+func CreateAuction(
+	productName, category, description string,
+	condition ProductCondition,
+) (*Auction, *internal_error.InternalError) {
+	return newAuction(productName, category, description, condition, Forward, time.Time{})
+}
+
+func CreateReverseAuction(
+	productName, category, description string,
+	condition ProductCondition,
+) (*Auction, *internal_error.InternalError) {
+	return newAuction(productName, category, description, condition, Reverse, time.Time{})
+}
+
+// CreateScheduledAuction creates a Forward auction that stays Upcoming
+// until beginAt, instead of going Active immediately.
+func CreateScheduledAuction(
+	productName, category, description string,
+	condition ProductCondition,
+	beginAt time.Time,
+) (*Auction, *internal_error.InternalError) {
+	return newAuction(productName, category, description, condition, Forward, beginAt)
+}
+
+// CreateReverseScheduledAuction creates a Reverse auction that stays
+// Upcoming until beginAt, instead of going Active immediately.
+func CreateReverseScheduledAuction(
+	productName, category, description string,
+	condition ProductCondition,
+	beginAt time.Time,
+) (*Auction, *internal_error.InternalError) {
+	return newAuction(productName, category, description, condition, Reverse, beginAt)
+}
+
+func newAuction(
+	productName, category, description string,
+	condition ProductCondition,
+	kind AuctionKind,
+	beginAt time.Time,
+) (*Auction, *internal_error.InternalError) {
+	now := time.Now()
+
+	status := Active
+	if beginAt.After(now) {
+		status = Upcoming
+	} else {
+		beginAt = now
+	}
+
+	auction := &Auction{
+		Id:          uuid.New().String(),
+		ProductName: productName,
+		Category:    category,
+		Description: description,
+		Condition:   condition,
+		Kind:        kind,
+		Status:      status,
+		Timestamp:   now,
+		BeginAt:     beginAt,
+	}
+
+	if err := auction.Validate(); err != nil {
+		return nil, err
+	}
+
+	return auction, nil
+}
+
+func (a *Auction) Validate() *internal_error.InternalError {
+	if len(a.ProductName) <= 1 ||
+		len(a.Category) <= 2 ||
+		len(a.Description) <= 10 ||
+		(a.Condition != New && a.Condition != Used && a.Condition != Refurbished) {
+		return internal_error.NewBadRequestError("invalid auction object")
+	}
+
+	if a.Kind != Forward && a.Kind != Reverse {
+		return internal_error.NewBadRequestError("invalid auction kind")
+	}
+
+	return nil
+}
+
+type AuctionRepositoryInterface interface {
+	CreateAuction(ctx context.Context, auctionEntity *Auction) *internal_error.InternalError
+	FindAuctionById(ctx context.Context, id string) (*Auction, *internal_error.InternalError)
+	// ExtendDeadlineOnLateBid pushes an auction's endsAt forward when a bid
+	// just landed inside the anti-sniping window, up to the configured cap.
+	// It is a no-op, not an error, when the bid fell outside the window,
+	// the cap was already reached, or the auction already closed.
+	ExtendDeadlineOnLateBid(ctx context.Context, auctionId string) *internal_error.InternalError
+}
@@ -0,0 +1,49 @@
+package bid_entity
+
+import (
+	"context"
+	"time"
+
+	"fullcycle-auction_go/internal/entity/auction_entity"
+	"fullcycle-auction_go/internal/internal_error"
+
+	"github.com/google/uuid"
+)
+
+type Bid struct {
+	Id        string
+	AuctionId string
+	UserId    string
+	Amount    float64
+	Timestamp time.Time
+}
+
+func CreateBid(auctionId, userId string, amount float64) (*Bid, *internal_error.InternalError) {
+	bid := &Bid{
+		Id:        uuid.New().String(),
+		AuctionId: auctionId,
+		UserId:    userId,
+		Amount:    amount,
+		Timestamp: time.Now(),
+	}
+
+	if err := bid.Validate(); err != nil {
+		return nil, err
+	}
+
+	return bid, nil
+}
+
+func (b *Bid) Validate() *internal_error.InternalError {
+	if len(b.AuctionId) == 0 || len(b.UserId) == 0 || b.Amount <= 0 {
+		return internal_error.NewBadRequestError("invalid bid object")
+	}
+	return nil
+}
+
+type BidRepositoryInterface interface {
+	CreateBid(ctx context.Context, bidEntity *Bid) *internal_error.InternalError
+	// FindWinningBid returns the bid currently winning the auction: the
+	// highest amount for a Forward auction, or the lowest for a Reverse one.
+	FindWinningBid(ctx context.Context, auctionId string, kind auction_entity.AuctionKind) (*Bid, *internal_error.InternalError)
+}
@@ -0,0 +1,207 @@
+package auction
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"fullcycle-auction_go/internal/entity/auction_entity"
+	"fullcycle-auction_go/internal/infra/database/bid"
+	"fullcycle-auction_go/internal/internal_error"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type AuctionEntityMongo struct {
+	Id             string                          `bson:"_id"`
+	ProductName    string                          `bson:"product_name"`
+	Category       string                          `bson:"category"`
+	Description    string                          `bson:"description"`
+	Condition      auction_entity.ProductCondition `bson:"condition"`
+	Kind           auction_entity.AuctionKind      `bson:"kind"`
+	Status         auction_entity.AuctionStatus    `bson:"status"`
+	Timestamp      int64                           `bson:"timestamp"`
+	BeginAt        int64                           `bson:"begin_at"`
+	EndsAt         int64                           `bson:"ends_at"`
+	ExtensionsUsed int                             `bson:"extensions_used"`
+	WinnerBidId    string                          `bson:"winner_bid_id,omitempty"`
+}
+
+// closeWorkerTick bounds how stale an expired auction can be before the
+// close queue worker picks it up. It is not configurable via env var
+// because, unlike AUCTION_INTERVAL, it is not part of the auction domain.
+const closeWorkerTick = 500 * time.Millisecond
+
+type AuctionRepository struct {
+	Collection    *mongo.Collection
+	BidRepository *bid.BidRepository
+	done          chan struct{}
+}
+
+// NewAuctionRepository wires the repository, recovers any auction that
+// expired while the process was down, and starts the single background
+// worker that drains the close queue. Unlike a per-auction goroutine,
+// the queue lives in Mongo, indexed on {status, ends_at}, so it survives
+// a restart; the worker itself stops with Close or the process.
+func NewAuctionRepository(database *mongo.Database) *AuctionRepository {
+	ar := &AuctionRepository{
+		Collection:    database.Collection("auctions"),
+		BidRepository: bid.NewBidRepository(database),
+		done:          make(chan struct{}),
+	}
+
+	ctx := context.Background()
+	ar.ensureIndexes(ctx)
+	ar.RecoverPendingAuctions(ctx)
+
+	go ar.closeWorker()
+
+	return ar
+}
+
+// Close stops the close queue worker. It does not close the underlying
+// mongo connection, which the caller owns.
+func (ar *AuctionRepository) Close() {
+	close(ar.done)
+}
+
+func (ar *AuctionRepository) ensureIndexes(ctx context.Context) {
+	ar.Collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "ends_at", Value: 1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "begin_at", Value: 1}}},
+	})
+}
+
+func (ar *AuctionRepository) CreateAuction(
+	ctx context.Context,
+	auctionEntity *auction_entity.Auction,
+) *internal_error.InternalError {
+	auctionEntityMongo := &AuctionEntityMongo{
+		Id:             auctionEntity.Id,
+		ProductName:    auctionEntity.ProductName,
+		Category:       auctionEntity.Category,
+		Description:    auctionEntity.Description,
+		Condition:      auctionEntity.Condition,
+		Kind:           auctionEntity.Kind,
+		Status:         auctionEntity.Status,
+		Timestamp:      auctionEntity.Timestamp.Unix(),
+		BeginAt:        auctionEntity.BeginAt.Unix(),
+		EndsAt:         auctionEntity.BeginAt.Add(getAuctionInterval()).Unix(),
+		ExtensionsUsed: 0,
+	}
+
+	if _, err := ar.Collection.InsertOne(ctx, auctionEntityMongo); err != nil {
+		return internal_error.NewInternalServerError("error trying to insert auction")
+	}
+
+	return nil
+}
+
+// RecoverPendingAuctions advances any auction whose BeginAt or EndsAt
+// already passed, e.g. because the process was down when either fired.
+// It is idempotent and is called once at construction, before the close
+// worker starts ticking.
+func (ar *AuctionRepository) RecoverPendingAuctions(ctx context.Context) *internal_error.InternalError {
+	ar.activatePendingAuctions(ctx)
+	return ar.closeExpiredAuctions(ctx)
+}
+
+func (ar *AuctionRepository) closeWorker() {
+	ticker := time.NewTicker(closeWorkerTick)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx := context.Background()
+			ar.activatePendingAuctions(ctx)
+			ar.closeExpiredAuctions(ctx)
+		case <-ar.done:
+			return
+		}
+	}
+}
+
+// activatePendingAuctions transitions every Upcoming auction whose
+// beginAt has passed to Active, in a single conditional UpdateMany.
+func (ar *AuctionRepository) activatePendingAuctions(ctx context.Context) *internal_error.InternalError {
+	if _, err := ar.Collection.UpdateMany(
+		ctx,
+		bson.M{"status": auction_entity.Upcoming, "begin_at": bson.M{"$lte": time.Now().Unix()}},
+		bson.M{"$set": bson.M{"status": auction_entity.Active}},
+	); err != nil {
+		return internal_error.NewInternalServerError("error trying to activate pending auctions")
+	}
+	return nil
+}
+
+// closeExpiredAuctions atomically transitions every Active auction whose
+// endsAt has passed to Completed via a single conditional UpdateMany
+// (status must still be Active, so a manual or concurrent close is never
+// overwritten), then resolves and records the winning bid for each one
+// closed in this pass.
+func (ar *AuctionRepository) closeExpiredAuctions(ctx context.Context) *internal_error.InternalError {
+	now := time.Now().Unix()
+	filter := bson.M{
+		"status":  auction_entity.Active,
+		"ends_at": bson.M{"$lte": now},
+	}
+
+	cursor, err := ar.Collection.Find(ctx, filter)
+	if err != nil {
+		return internal_error.NewInternalServerError("error trying to find expired auctions")
+	}
+	defer cursor.Close(ctx)
+
+	var expired []AuctionEntityMongo
+	if err := cursor.All(ctx, &expired); err != nil {
+		return internal_error.NewInternalServerError("error trying to decode expired auctions")
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(expired))
+	for i, a := range expired {
+		ids[i] = a.Id
+	}
+
+	// Re-asserting ends_at (not just status) guards against a bid that
+	// extended the deadline (ExtendDeadlineOnLateBid) between the Find
+	// above and this UpdateMany: such an auction no longer matches and is
+	// left Active instead of being closed on its now-stale ends_at.
+	if _, err := ar.Collection.UpdateMany(
+		ctx,
+		bson.M{"_id": bson.M{"$in": ids}, "status": auction_entity.Active, "ends_at": bson.M{"$lte": now}},
+		bson.M{"$set": bson.M{"status": auction_entity.Completed}},
+	); err != nil {
+		return internal_error.NewInternalServerError("error trying to close expired auctions")
+	}
+
+	for _, a := range expired {
+		winningBid, bidErr := ar.BidRepository.FindWinningBid(ctx, a.Id, a.Kind)
+		if bidErr != nil {
+			continue
+		}
+		// status: Completed guards against a's deadline having been
+		// extended out from under this pass (see the UpdateMany above):
+		// an auction that's still Active must not get a winner_bid_id.
+		ar.Collection.UpdateOne(
+			ctx,
+			bson.M{"_id": a.Id, "status": auction_entity.Completed},
+			bson.M{"$set": bson.M{"winner_bid_id": winningBid.Id}},
+		)
+	}
+
+	return nil
+}
+
+func getAuctionInterval() time.Duration {
+	interval := os.Getenv("AUCTION_INTERVAL")
+	duration, err := time.ParseDuration(interval)
+	if err != nil {
+		return 5 * time.Minute
+	}
+	return duration
+}
@@ -0,0 +1,86 @@
+package auction
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"fullcycle-auction_go/internal/entity/auction_entity"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestRecoverPendingAuctions_ClosesAlreadyExpired(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupMongoContainer(ctx, t)
+	defer cleanup()
+
+	// Simula um leilão que expirou enquanto o processo estava parado:
+	// inserimos o documento diretamente, sem passar por CreateAuction.
+	auction := &AuctionEntityMongo{
+		Id:          "expired-while-down",
+		ProductName: "Produto Teste",
+		Category:    "Eletrônicos",
+		Description: "Descrição do produto teste",
+		Condition:   auction_entity.New,
+		Kind:        auction_entity.Forward,
+		Status:      auction_entity.Active,
+		Timestamp:   time.Now().Add(-10 * time.Minute).Unix(),
+		EndsAt:      time.Now().Add(-5 * time.Minute).Unix(),
+	}
+
+	repo := NewAuctionRepository(database)
+	_, err := repo.Collection.InsertOne(ctx, auction)
+	assert.Nil(t, err)
+
+	recoverErr := repo.RecoverPendingAuctions(ctx)
+	assert.Nil(t, recoverErr)
+
+	var auctionMongo AuctionEntityMongo
+	findErr := repo.Collection.FindOne(ctx, bson.M{"_id": auction.Id}).Decode(&auctionMongo)
+	assert.Nil(t, findErr)
+	assert.Equal(t, auction_entity.Completed, auctionMongo.Status)
+}
+
+func TestCreateAuction_SurvivesRepositoryRestart(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupMongoContainer(ctx, t)
+	defer cleanup()
+
+	os.Setenv("AUCTION_INTERVAL", "2s")
+	defer os.Unsetenv("AUCTION_INTERVAL")
+
+	repo := NewAuctionRepository(database)
+
+	auction, err := auction_entity.CreateAuction(
+		"Produto Teste",
+		"Eletrônicos",
+		"Descrição do produto teste",
+		auction_entity.New,
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, repo.CreateAuction(ctx, auction))
+
+	// Simula o restart do processo: o worker original é parado antes de
+	// ter tido a chance de fechar o leilão sozinho.
+	repo.Close()
+
+	time.Sleep(2500 * time.Millisecond)
+
+	var auctionMongo AuctionEntityMongo
+	findErr := repo.Collection.FindOne(ctx, bson.M{"_id": auction.Id}).Decode(&auctionMongo)
+	assert.Nil(t, findErr)
+	assert.Equal(t, auction_entity.Active, auctionMongo.Status,
+		"sem um worker rodando, o leilão expirado não deveria se fechar sozinho")
+
+	// Um novo repositório sobre a mesma collection deve recuperar o leilão
+	// pendente já na construção, sem depender do worker original.
+	repo = NewAuctionRepository(database)
+
+	findErr = repo.Collection.FindOne(ctx, bson.M{"_id": auction.Id}).Decode(&auctionMongo)
+	assert.Nil(t, findErr)
+	assert.Equal(t, auction_entity.Completed, auctionMongo.Status,
+		"o leilão deveria fechar assim que um novo repositório assumir a recuperação")
+}
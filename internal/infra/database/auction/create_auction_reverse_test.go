@@ -0,0 +1,94 @@
+package auction
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"fullcycle-auction_go/internal/entity/auction_entity"
+	"fullcycle-auction_go/internal/entity/bid_entity"
+	"fullcycle-auction_go/internal/infra/database/bid"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestCreateAuction_Forward_AutoClose_PicksHighestBid(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupMongoContainer(ctx, t)
+	defer cleanup()
+
+	os.Setenv("AUCTION_INTERVAL", "2s")
+	defer os.Unsetenv("AUCTION_INTERVAL")
+
+	auctionRepo := NewAuctionRepository(database)
+	bidRepo := bid.NewBidRepository(database)
+
+	auction, err := auction_entity.CreateAuction(
+		"Produto Teste",
+		"Eletrônicos",
+		"Descrição do produto teste",
+		auction_entity.New,
+	)
+	assert.Nil(t, err)
+
+	internalErr := auctionRepo.CreateAuction(ctx, auction)
+	assert.Nil(t, internalErr)
+
+	lowBid, err := bid_entity.CreateBid(auction.Id, "user-1", 100)
+	assert.Nil(t, err)
+	assert.Nil(t, bidRepo.CreateBid(ctx, lowBid))
+
+	highBid, err := bid_entity.CreateBid(auction.Id, "user-2", 200)
+	assert.Nil(t, err)
+	assert.Nil(t, bidRepo.CreateBid(ctx, highBid))
+
+	time.Sleep(3 * time.Second)
+
+	var auctionMongo AuctionEntityMongo
+	findErr := auctionRepo.Collection.FindOne(ctx, bson.M{"_id": auction.Id}).Decode(&auctionMongo)
+	assert.Nil(t, findErr)
+	assert.Equal(t, auction_entity.Completed, auctionMongo.Status)
+	assert.Equal(t, highBid.Id, auctionMongo.WinnerBidId, "o lance mais alto deveria vencer o leilão forward")
+}
+
+func TestCreateAuction_Reverse_AutoClose_PicksLowestBid(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupMongoContainer(ctx, t)
+	defer cleanup()
+
+	os.Setenv("AUCTION_INTERVAL", "2s")
+	defer os.Unsetenv("AUCTION_INTERVAL")
+
+	auctionRepo := NewAuctionRepository(database)
+	bidRepo := bid.NewBidRepository(database)
+
+	auction, err := auction_entity.CreateReverseAuction(
+		"Lote de Parafusos",
+		"Industrial",
+		"Fornecimento de lote de parafusos",
+		auction_entity.New,
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, auction_entity.Reverse, auction.Kind)
+
+	internalErr := auctionRepo.CreateAuction(ctx, auction)
+	assert.Nil(t, internalErr)
+
+	highBid, err := bid_entity.CreateBid(auction.Id, "seller-1", 200)
+	assert.Nil(t, err)
+	assert.Nil(t, bidRepo.CreateBid(ctx, highBid))
+
+	lowBid, err := bid_entity.CreateBid(auction.Id, "seller-2", 100)
+	assert.Nil(t, err)
+	assert.Nil(t, bidRepo.CreateBid(ctx, lowBid))
+
+	time.Sleep(3 * time.Second)
+
+	var auctionMongo AuctionEntityMongo
+	findErr := auctionRepo.Collection.FindOne(ctx, bson.M{"_id": auction.Id}).Decode(&auctionMongo)
+	assert.Nil(t, findErr)
+	assert.Equal(t, auction_entity.Completed, auctionMongo.Status)
+	assert.Equal(t, lowBid.Id, auctionMongo.WinnerBidId, "o lance mais baixo deveria vencer o leilão reverse")
+}
@@ -0,0 +1,56 @@
+package auction
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"fullcycle-auction_go/internal/entity/auction_entity"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestCreateScheduledAuction_GoesThroughUpcomingActiveCompleted(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupMongoContainer(ctx, t)
+	defer cleanup()
+
+	os.Setenv("AUCTION_INTERVAL", "2s")
+	defer os.Unsetenv("AUCTION_INTERVAL")
+
+	repo := NewAuctionRepository(database)
+
+	beginAt := time.Now().Add(2 * time.Second)
+	scheduledAuction, err := auction_entity.CreateScheduledAuction(
+		"Produto Teste",
+		"Eletrônicos",
+		"Descrição do produto teste",
+		auction_entity.New,
+		beginAt,
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, auction_entity.Upcoming, scheduledAuction.Status)
+
+	assert.Nil(t, repo.CreateAuction(ctx, scheduledAuction))
+
+	var auctionMongo AuctionEntityMongo
+	findErr := repo.Collection.FindOne(ctx, bson.M{"_id": scheduledAuction.Id}).Decode(&auctionMongo)
+	assert.Nil(t, findErr)
+	assert.Equal(t, auction_entity.Upcoming, auctionMongo.Status)
+
+	time.Sleep(3 * time.Second)
+
+	findErr = repo.Collection.FindOne(ctx, bson.M{"_id": scheduledAuction.Id}).Decode(&auctionMongo)
+	assert.Nil(t, findErr)
+	assert.Equal(t, auction_entity.Active, auctionMongo.Status,
+		"o leilão deveria estar ativo após o beginAt")
+
+	time.Sleep(3 * time.Second)
+
+	findErr = repo.Collection.FindOne(ctx, bson.M{"_id": scheduledAuction.Id}).Decode(&auctionMongo)
+	assert.Nil(t, findErr)
+	assert.Equal(t, auction_entity.Completed, auctionMongo.Status,
+		"o leilão deveria fechar após beginAt + interval")
+}
@@ -0,0 +1,77 @@
+package auction
+
+import (
+	"context"
+	"math"
+	"os"
+	"strconv"
+	"time"
+
+	"fullcycle-auction_go/internal/entity/auction_entity"
+	"fullcycle-auction_go/internal/internal_error"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ExtendDeadlineOnLateBid implements anti-sniping: if the auction is
+// still Active, its endsAt is within AUCTION_EXTENSION_WINDOW of now,
+// and fewer than AUCTION_MAX_EXTENSIONS extensions have been granted, it
+// atomically pushes endsAt forward by AUCTION_EXTENSION_AMOUNT and bumps
+// extensionsUsed. The condition is evaluated by Mongo itself as part of
+// the FindOneAndUpdate filter, so two bids racing the same closing
+// window can't both extend past the cap.
+func (ar *AuctionRepository) ExtendDeadlineOnLateBid(
+	ctx context.Context,
+	auctionId string,
+) *internal_error.InternalError {
+	window := getExtensionWindow()
+	amount := getExtensionAmount()
+	if window <= 0 || amount <= 0 {
+		return nil
+	}
+
+	filter := bson.M{
+		"_id":             auctionId,
+		"status":          auction_entity.Active,
+		"ends_at":         bson.M{"$lt": time.Now().Add(window).Unix()},
+		"extensions_used": bson.M{"$lt": getMaxExtensions()},
+	}
+	update := bson.M{
+		"$inc": bson.M{
+			"ends_at":         int64(amount.Seconds()),
+			"extensions_used": 1,
+		},
+	}
+
+	err := ar.Collection.FindOneAndUpdate(ctx, filter, update).Err()
+	if err != nil && err != mongo.ErrNoDocuments {
+		return internal_error.NewInternalServerError("error trying to extend auction deadline")
+	}
+
+	return nil
+}
+
+func getExtensionWindow() time.Duration {
+	window, err := time.ParseDuration(os.Getenv("AUCTION_EXTENSION_WINDOW"))
+	if err != nil {
+		return 0
+	}
+	return window
+}
+
+func getExtensionAmount() time.Duration {
+	amount, err := time.ParseDuration(os.Getenv("AUCTION_EXTENSION_AMOUNT"))
+	if err != nil {
+		return 0
+	}
+	return amount
+}
+
+func getMaxExtensions() int {
+	max, err := strconv.Atoi(os.Getenv("AUCTION_MAX_EXTENSIONS"))
+	if err != nil || max <= 0 {
+		return math.MaxInt32
+	}
+	return max
+}
@@ -0,0 +1,101 @@
+package auction
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"fullcycle-auction_go/internal/entity/auction_entity"
+	"fullcycle-auction_go/internal/entity/bid_entity"
+	"fullcycle-auction_go/internal/infra/database/bid"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestExtendDeadlineOnLateBid_WithinWindow_ExtendsDeadline(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupMongoContainer(ctx, t)
+	defer cleanup()
+
+	os.Setenv("AUCTION_INTERVAL", "2s")
+	os.Setenv("AUCTION_EXTENSION_WINDOW", "3s")
+	os.Setenv("AUCTION_EXTENSION_AMOUNT", "2s")
+	defer os.Unsetenv("AUCTION_INTERVAL")
+	defer os.Unsetenv("AUCTION_EXTENSION_WINDOW")
+	defer os.Unsetenv("AUCTION_EXTENSION_AMOUNT")
+
+	auctionRepo := NewAuctionRepository(database)
+	bidRepo := bid.NewBidRepository(database)
+
+	createdAuction, err := auction_entity.CreateAuction(
+		"Produto Teste", "Eletrônicos", "Descrição do produto teste", auction_entity.New,
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, auctionRepo.CreateAuction(ctx, createdAuction))
+
+	lateBid, err := bid_entity.CreateBid(createdAuction.Id, "user-1", 100)
+	assert.Nil(t, err)
+	assert.Nil(t, bidRepo.CreateBid(ctx, lateBid))
+
+	// O lance chega bem dentro da janela de 3s antes do endsAt original
+	// (2s após a criação), então deve empurrar o endsAt mais 2s.
+	assert.Nil(t, auctionRepo.ExtendDeadlineOnLateBid(ctx, createdAuction.Id))
+
+	time.Sleep(2500 * time.Millisecond)
+
+	var auctionMongo AuctionEntityMongo
+	findErr := auctionRepo.Collection.FindOne(ctx, bson.M{"_id": createdAuction.Id}).Decode(&auctionMongo)
+	assert.Nil(t, findErr)
+	assert.Equal(t, auction_entity.Active, auctionMongo.Status,
+		"o leilão deveria continuar ativo além do intervalo original por causa da extensão")
+	assert.Equal(t, 1, auctionMongo.ExtensionsUsed)
+
+	time.Sleep(2 * time.Second)
+
+	findErr = auctionRepo.Collection.FindOne(ctx, bson.M{"_id": createdAuction.Id}).Decode(&auctionMongo)
+	assert.Nil(t, findErr)
+	assert.Equal(t, auction_entity.Completed, auctionMongo.Status,
+		"o leilão deveria fechar após o endsAt estendido")
+}
+
+func TestExtendDeadlineOnLateBid_CapRespected(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupMongoContainer(ctx, t)
+	defer cleanup()
+
+	os.Setenv("AUCTION_INTERVAL", "2s")
+	os.Setenv("AUCTION_EXTENSION_WINDOW", "5s")
+	os.Setenv("AUCTION_EXTENSION_AMOUNT", "2s")
+	os.Setenv("AUCTION_MAX_EXTENSIONS", "1")
+	defer os.Unsetenv("AUCTION_INTERVAL")
+	defer os.Unsetenv("AUCTION_EXTENSION_WINDOW")
+	defer os.Unsetenv("AUCTION_EXTENSION_AMOUNT")
+	defer os.Unsetenv("AUCTION_MAX_EXTENSIONS")
+
+	auctionRepo := NewAuctionRepository(database)
+	bidRepo := bid.NewBidRepository(database)
+
+	createdAuction, err := auction_entity.CreateAuction(
+		"Produto Teste", "Eletrônicos", "Descrição do produto teste", auction_entity.New,
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, auctionRepo.CreateAuction(ctx, createdAuction))
+
+	firstBid, err := bid_entity.CreateBid(createdAuction.Id, "user-1", 100)
+	assert.Nil(t, err)
+	assert.Nil(t, bidRepo.CreateBid(ctx, firstBid))
+	assert.Nil(t, auctionRepo.ExtendDeadlineOnLateBid(ctx, createdAuction.Id))
+
+	secondBid, err := bid_entity.CreateBid(createdAuction.Id, "user-2", 110)
+	assert.Nil(t, err)
+	assert.Nil(t, bidRepo.CreateBid(ctx, secondBid))
+	assert.Nil(t, auctionRepo.ExtendDeadlineOnLateBid(ctx, createdAuction.Id))
+
+	var auctionMongo AuctionEntityMongo
+	findErr := auctionRepo.Collection.FindOne(ctx, bson.M{"_id": createdAuction.Id}).Decode(&auctionMongo)
+	assert.Nil(t, findErr)
+	assert.Equal(t, 1, auctionMongo.ExtensionsUsed,
+		"o segundo lance tardio não deveria estender o leilão além do teto de AUCTION_MAX_EXTENSIONS")
+}
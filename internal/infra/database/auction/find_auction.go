@@ -0,0 +1,37 @@
+package auction
+
+import (
+	"context"
+	"time"
+
+	"fullcycle-auction_go/internal/entity/auction_entity"
+	"fullcycle-auction_go/internal/internal_error"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func (ar *AuctionRepository) FindAuctionById(
+	ctx context.Context,
+	id string,
+) (*auction_entity.Auction, *internal_error.InternalError) {
+	var auctionEntityMongo AuctionEntityMongo
+	if err := ar.Collection.FindOne(ctx, bson.M{"_id": id}).Decode(&auctionEntityMongo); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, internal_error.NewNotFoundError("auction not found")
+		}
+		return nil, internal_error.NewInternalServerError("error trying to find auction by id")
+	}
+
+	return &auction_entity.Auction{
+		Id:          auctionEntityMongo.Id,
+		ProductName: auctionEntityMongo.ProductName,
+		Category:    auctionEntityMongo.Category,
+		Description: auctionEntityMongo.Description,
+		Condition:   auctionEntityMongo.Condition,
+		Kind:        auctionEntityMongo.Kind,
+		Status:      auctionEntityMongo.Status,
+		Timestamp:   time.Unix(auctionEntityMongo.Timestamp, 0),
+		BeginAt:     time.Unix(auctionEntityMongo.BeginAt, 0),
+	}, nil
+}
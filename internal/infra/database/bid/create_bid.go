@@ -0,0 +1,93 @@
+package bid
+
+import (
+	"context"
+	"time"
+
+	"fullcycle-auction_go/internal/entity/auction_entity"
+	"fullcycle-auction_go/internal/entity/bid_entity"
+	"fullcycle-auction_go/internal/internal_error"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type BidEntityMongo struct {
+	Id        string  `bson:"_id"`
+	AuctionId string  `bson:"auction_id"`
+	UserId    string  `bson:"user_id"`
+	Amount    float64 `bson:"amount"`
+	Timestamp int64   `bson:"timestamp"`
+}
+
+type BidRepository struct {
+	Collection *mongo.Collection
+}
+
+func NewBidRepository(database *mongo.Database) *BidRepository {
+	br := &BidRepository{
+		Collection: database.Collection("bids"),
+	}
+
+	br.ensureIndexes(context.Background())
+
+	return br
+}
+
+// ensureIndexes keeps the current-highest/lowest-bid lookup in
+// FindWinningBid an O(log n) index scan instead of a collection scan.
+func (br *BidRepository) ensureIndexes(ctx context.Context) {
+	br.Collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "auction_id", Value: 1}, {Key: "amount", Value: 1}},
+	})
+}
+
+func (br *BidRepository) CreateBid(ctx context.Context, bidEntity *bid_entity.Bid) *internal_error.InternalError {
+	bidEntityMongo := &BidEntityMongo{
+		Id:        bidEntity.Id,
+		AuctionId: bidEntity.AuctionId,
+		UserId:    bidEntity.UserId,
+		Amount:    bidEntity.Amount,
+		Timestamp: bidEntity.Timestamp.Unix(),
+	}
+
+	if _, err := br.Collection.InsertOne(ctx, bidEntityMongo); err != nil {
+		return internal_error.NewInternalServerError("error trying to insert bid")
+	}
+
+	return nil
+}
+
+// FindWinningBid returns the bid currently winning the auction: the
+// highest amount for a Forward auction, or the lowest amount for a
+// Reverse auction.
+func (br *BidRepository) FindWinningBid(
+	ctx context.Context,
+	auctionId string,
+	kind auction_entity.AuctionKind,
+) (*bid_entity.Bid, *internal_error.InternalError) {
+	sortOrder := -1
+	if kind == auction_entity.Reverse {
+		sortOrder = 1
+	}
+
+	opts := options.FindOne().SetSort(bson.D{{Key: "amount", Value: sortOrder}})
+
+	var bidEntityMongo BidEntityMongo
+	filter := bson.M{"auction_id": auctionId}
+	if err := br.Collection.FindOne(ctx, filter, opts).Decode(&bidEntityMongo); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, internal_error.NewNotFoundError("no bids found for this auction")
+		}
+		return nil, internal_error.NewInternalServerError("error trying to find winning bid")
+	}
+
+	return &bid_entity.Bid{
+		Id:        bidEntityMongo.Id,
+		AuctionId: bidEntityMongo.AuctionId,
+		UserId:    bidEntityMongo.UserId,
+		Amount:    bidEntityMongo.Amount,
+		Timestamp: time.Unix(bidEntityMongo.Timestamp, 0),
+	}, nil
+}
@@ -0,0 +1,41 @@
+package internal_error
+
+type InternalError struct {
+	Message string
+	Err     string
+}
+
+func NewBadRequestError(message string) *InternalError {
+	return &InternalError{
+		Message: message,
+		Err:     "bad_request",
+	}
+}
+
+func NewInternalServerError(message string) *InternalError {
+	return &InternalError{
+		Message: message,
+		Err:     "internal_server_error",
+	}
+}
+
+func NewNotFoundError(message string) *InternalError {
+	return &InternalError{
+		Message: message,
+		Err:     "not_found",
+	}
+}
+
+// NewOutbidError reports a bid rejected by the auction's bid rules (below
+// the minimum bid, or not enough above/below the current winning bid),
+// distinguishable from a generic bad-request validation failure.
+func NewOutbidError(message string) *InternalError {
+	return &InternalError{
+		Message: message,
+		Err:     "outbid_error",
+	}
+}
+
+func (e *InternalError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,67 @@
+package bid_usecase
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"fullcycle-auction_go/internal/entity/auction_entity"
+	"fullcycle-auction_go/internal/entity/bid_entity"
+	"fullcycle-auction_go/internal/internal_error"
+)
+
+// validateBidRules enforces the two configurable bid rules: the amount
+// must meet AUCTION_MIN_BID, and each subsequent bid must beat the
+// current winning bid by at least AUCTION_OUTBID_BASIS_POINTS (in basis
+// points of that bid's amount) — higher for a Forward auction, lower for
+// a Reverse one. An auction with no bids yet always accepts the first
+// bid that meets the minimum.
+func validateBidRules(
+	ctx context.Context,
+	bidRepository bid_entity.BidRepositoryInterface,
+	auction *auction_entity.Auction,
+	amount float64,
+) *internal_error.InternalError {
+	if amount < getMinBid() {
+		return internal_error.NewOutbidError("bid amount is below the auction minimum bid")
+	}
+
+	currentBid, err := bidRepository.FindWinningBid(ctx, auction.Id, auction.Kind)
+	if err != nil {
+		if err.Err == "not_found" {
+			return nil
+		}
+		return err
+	}
+
+	minDelta := currentBid.Amount * (float64(getOutbidBasisPoints()) / 10000)
+
+	if auction.Kind == auction_entity.Reverse {
+		if amount > currentBid.Amount-minDelta {
+			return internal_error.NewOutbidError("bid must undercut the current lowest bid by the configured outbid percentage")
+		}
+		return nil
+	}
+
+	if amount < currentBid.Amount+minDelta {
+		return internal_error.NewOutbidError("bid must exceed the current highest bid by the configured outbid percentage")
+	}
+
+	return nil
+}
+
+func getMinBid() float64 {
+	minBid, err := strconv.ParseFloat(os.Getenv("AUCTION_MIN_BID"), 64)
+	if err != nil {
+		return 0
+	}
+	return minBid
+}
+
+func getOutbidBasisPoints() int {
+	bps, err := strconv.Atoi(os.Getenv("AUCTION_OUTBID_BASIS_POINTS"))
+	if err != nil {
+		return 0
+	}
+	return bps
+}
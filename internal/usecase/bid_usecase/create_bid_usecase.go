@@ -0,0 +1,56 @@
+package bid_usecase
+
+import (
+	"context"
+
+	"fullcycle-auction_go/internal/entity/auction_entity"
+	"fullcycle-auction_go/internal/entity/bid_entity"
+	"fullcycle-auction_go/internal/internal_error"
+)
+
+type BidInputDTO struct {
+	AuctionId string
+	UserId    string
+	Amount    float64
+}
+
+type BidUseCase struct {
+	BidRepository     bid_entity.BidRepositoryInterface
+	AuctionRepository auction_entity.AuctionRepositoryInterface
+}
+
+func NewBidUseCase(
+	bidRepository bid_entity.BidRepositoryInterface,
+	auctionRepository auction_entity.AuctionRepositoryInterface,
+) *BidUseCase {
+	return &BidUseCase{
+		BidRepository:     bidRepository,
+		AuctionRepository: auctionRepository,
+	}
+}
+
+func (bu *BidUseCase) CreateBid(ctx context.Context, input BidInputDTO) *internal_error.InternalError {
+	bid, err := bid_entity.CreateBid(input.AuctionId, input.UserId, input.Amount)
+	if err != nil {
+		return err
+	}
+
+	auction, err := bu.AuctionRepository.FindAuctionById(ctx, input.AuctionId)
+	if err != nil {
+		return err
+	}
+
+	if auction.Status != auction_entity.Active {
+		return internal_error.NewBadRequestError("auction is not accepting bids")
+	}
+
+	if err := validateBidRules(ctx, bu.BidRepository, auction, bid.Amount); err != nil {
+		return err
+	}
+
+	if err := bu.BidRepository.CreateBid(ctx, bid); err != nil {
+		return err
+	}
+
+	return bu.AuctionRepository.ExtendDeadlineOnLateBid(ctx, auction.Id)
+}
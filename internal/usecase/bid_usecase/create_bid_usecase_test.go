@@ -0,0 +1,254 @@
+package bid_usecase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"fullcycle-auction_go/internal/entity/auction_entity"
+	"fullcycle-auction_go/internal/infra/database/auction"
+	"fullcycle-auction_go/internal/infra/database/bid"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/modules/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func setupMongoContainer(ctx context.Context, t *testing.T) (*mongo.Database, func()) {
+	mongodbContainer, err := mongodb.RunContainer(ctx, testcontainers.WithImage("mongo:6"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	endpoint, err := mongodbContainer.Endpoint(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mongoURI := fmt.Sprintf("mongodb://%s", endpoint)
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(mongoURI))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	database := client.Database("testdb")
+
+	cleanup := func() {
+		if err := client.Disconnect(ctx); err != nil {
+			t.Errorf("failed to disconnect from mongo: %v", err)
+		}
+		if err := mongodbContainer.Terminate(ctx); err != nil {
+			t.Errorf("failed to terminate container: %v", err)
+		}
+	}
+
+	return database, cleanup
+}
+
+func TestCreateBid_BelowMinimumBid_Rejected(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupMongoContainer(ctx, t)
+	defer cleanup()
+
+	os.Setenv("AUCTION_MIN_BID", "50")
+	defer os.Unsetenv("AUCTION_MIN_BID")
+
+	auctionRepo := auction.NewAuctionRepository(database)
+	bidRepo := bid.NewBidRepository(database)
+	useCase := NewBidUseCase(bidRepo, auctionRepo)
+
+	createdAuction, err := auction_entity.CreateAuction(
+		"Produto Teste", "Eletrônicos", "Descrição do produto teste", auction_entity.New,
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, auctionRepo.CreateAuction(ctx, createdAuction))
+
+	bidErr := useCase.CreateBid(ctx, BidInputDTO{
+		AuctionId: createdAuction.Id,
+		UserId:    "user-1",
+		Amount:    10,
+	})
+	assert.NotNil(t, bidErr)
+	assert.Equal(t, "outbid_error", bidErr.Err,
+		"um lance abaixo do mínimo deve ser um erro de domínio, não um erro de validação genérico")
+}
+
+func TestCreateBid_WithDifferentOutbidPercentages(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupMongoContainer(ctx, t)
+	defer cleanup()
+
+	tests := []struct {
+		name        string
+		outbidBps   string
+		amount      float64
+		expectError bool
+	}{
+		{"sem percentual mínimo, qualquer lance maior basta", "0", 100.01, false},
+		{"10% de outbid, lance insuficiente", "1000", 105, true},
+		{"10% de outbid, lance exatamente no limite é aceito", "1000", 110, false},
+		{"10% de outbid, lance suficiente", "1000", 110.01, false},
+		{"25% de outbid, lance insuficiente", "2500", 120, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Setenv("AUCTION_OUTBID_BASIS_POINTS", tt.outbidBps)
+			defer os.Unsetenv("AUCTION_OUTBID_BASIS_POINTS")
+
+			auctionRepo := auction.NewAuctionRepository(database)
+			bidRepo := bid.NewBidRepository(database)
+			useCase := NewBidUseCase(bidRepo, auctionRepo)
+
+			createdAuction, err := auction_entity.CreateAuction(
+				"Produto Teste", "Eletrônicos", "Descrição do produto teste", auction_entity.New,
+			)
+			assert.Nil(t, err)
+			assert.Nil(t, auctionRepo.CreateAuction(ctx, createdAuction))
+
+			assert.Nil(t, useCase.CreateBid(ctx, BidInputDTO{
+				AuctionId: createdAuction.Id, UserId: "user-1", Amount: 100,
+			}))
+
+			bidErr := useCase.CreateBid(ctx, BidInputDTO{
+				AuctionId: createdAuction.Id, UserId: "user-2", Amount: tt.amount,
+			})
+
+			if tt.expectError {
+				assert.NotNil(t, bidErr)
+				assert.Equal(t, "outbid_error", bidErr.Err,
+					"o erro de lance insuficiente deve ser distinguível de um erro de validação genérico")
+			} else {
+				assert.Nil(t, bidErr)
+			}
+		})
+	}
+}
+
+func TestCreateBid_Reverse_WithDifferentOutbidPercentages(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupMongoContainer(ctx, t)
+	defer cleanup()
+
+	tests := []struct {
+		name        string
+		outbidBps   string
+		amount      float64
+		expectError bool
+	}{
+		{"reverse, sem percentual mínimo, qualquer lance menor basta", "0", 99.99, false},
+		{"reverse, 10% de outbid, lance mais alto que o limite é rejeitado", "1000", 95, true},
+		{"reverse, 10% de outbid, lance exatamente no limite é aceito", "1000", 90, false},
+		{"reverse, 10% de outbid, lance suficientemente menor", "1000", 89.99, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Setenv("AUCTION_OUTBID_BASIS_POINTS", tt.outbidBps)
+			defer os.Unsetenv("AUCTION_OUTBID_BASIS_POINTS")
+
+			auctionRepo := auction.NewAuctionRepository(database)
+			bidRepo := bid.NewBidRepository(database)
+			useCase := NewBidUseCase(bidRepo, auctionRepo)
+
+			createdAuction, err := auction_entity.CreateReverseAuction(
+				"Produto Teste", "Eletrônicos", "Descrição do produto teste", auction_entity.New,
+			)
+			assert.Nil(t, err)
+			assert.Nil(t, auctionRepo.CreateAuction(ctx, createdAuction))
+
+			assert.Nil(t, useCase.CreateBid(ctx, BidInputDTO{
+				AuctionId: createdAuction.Id, UserId: "user-1", Amount: 100,
+			}))
+
+			bidErr := useCase.CreateBid(ctx, BidInputDTO{
+				AuctionId: createdAuction.Id, UserId: "user-2", Amount: tt.amount,
+			})
+
+			if tt.expectError {
+				assert.NotNil(t, bidErr)
+				assert.Equal(t, "outbid_error", bidErr.Err,
+					"o erro de lance insuficiente em um leilão reverse deve ser distinguível de um erro de validação genérico")
+			} else {
+				assert.Nil(t, bidErr)
+			}
+		})
+	}
+}
+
+func TestCreateBid_OnUpcomingAuction_Rejected(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupMongoContainer(ctx, t)
+	defer cleanup()
+
+	auctionRepo := auction.NewAuctionRepository(database)
+	bidRepo := bid.NewBidRepository(database)
+	useCase := NewBidUseCase(bidRepo, auctionRepo)
+
+	scheduledAuction, err := auction_entity.CreateScheduledAuction(
+		"Produto Teste", "Eletrônicos", "Descrição do produto teste",
+		auction_entity.New, time.Now().Add(1*time.Hour),
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, auctionRepo.CreateAuction(ctx, scheduledAuction))
+
+	bidErr := useCase.CreateBid(ctx, BidInputDTO{
+		AuctionId: scheduledAuction.Id,
+		UserId:    "user-1",
+		Amount:    100,
+	})
+	assert.NotNil(t, bidErr)
+	assert.Equal(t, "bad_request", bidErr.Err,
+		"um lance em um leilão upcoming deve ser rejeitado antes mesmo de avaliar as regras de outbid")
+}
+
+func TestCreateBid_WithinExtensionWindow_ExtendsAuctionDeadline(t *testing.T) {
+	ctx := context.Background()
+	database, cleanup := setupMongoContainer(ctx, t)
+	defer cleanup()
+
+	os.Setenv("AUCTION_INTERVAL", "2s")
+	os.Setenv("AUCTION_EXTENSION_WINDOW", "3s")
+	os.Setenv("AUCTION_EXTENSION_AMOUNT", "2s")
+	defer os.Unsetenv("AUCTION_INTERVAL")
+	defer os.Unsetenv("AUCTION_EXTENSION_WINDOW")
+	defer os.Unsetenv("AUCTION_EXTENSION_AMOUNT")
+
+	auctionRepo := auction.NewAuctionRepository(database)
+	bidRepo := bid.NewBidRepository(database)
+	useCase := NewBidUseCase(bidRepo, auctionRepo)
+
+	createdAuction, err := auction_entity.CreateAuction(
+		"Produto Teste", "Eletrônicos", "Descrição do produto teste", auction_entity.New,
+	)
+	assert.Nil(t, err)
+	assert.Nil(t, auctionRepo.CreateAuction(ctx, createdAuction))
+
+	// O lance chega bem dentro da janela de 3s antes do endsAt original
+	// (2s após a criação). É o useCase.CreateBid, não o teste, quem decide
+	// chamar ExtendDeadlineOnLateBid — isto cobre a integração real.
+	assert.Nil(t, useCase.CreateBid(ctx, BidInputDTO{
+		AuctionId: createdAuction.Id, UserId: "user-1", Amount: 100,
+	}))
+
+	time.Sleep(2500 * time.Millisecond)
+
+	var auctionMongo auction.AuctionEntityMongo
+	findErr := auctionRepo.Collection.FindOne(ctx, bson.M{"_id": createdAuction.Id}).Decode(&auctionMongo)
+	assert.Nil(t, findErr)
+	assert.Equal(t, auction_entity.Active, auctionMongo.Status,
+		"o leilão deveria continuar ativo além do intervalo original por causa da extensão disparada pelo CreateBid")
+	assert.Equal(t, 1, auctionMongo.ExtensionsUsed)
+
+	time.Sleep(2 * time.Second)
+
+	findErr = auctionRepo.Collection.FindOne(ctx, bson.M{"_id": createdAuction.Id}).Decode(&auctionMongo)
+	assert.Nil(t, findErr)
+	assert.Equal(t, auction_entity.Completed, auctionMongo.Status,
+		"o leilão deveria fechar após o endsAt estendido")
+}